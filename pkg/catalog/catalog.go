@@ -0,0 +1,72 @@
+// Package catalog parses Backstage catalog-info.yaml files and maps their
+// metadata onto cog's own project config, so a model whose repo is already
+// registered in a Backstage catalog doesn't need a second, hand-written
+// cog.yaml as its only source of truth.
+package catalog
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Component-level annotations cog understands, in the cog.replicate.com
+// namespace.
+const (
+	AnnotationPredict = "cog.replicate.com/predict"
+	AnnotationImage   = "cog.replicate.com/image"
+	AnnotationGPU     = "cog.replicate.com/gpu"
+)
+
+// Info is the subset of the Backstage Component schema cog reads out of
+// catalog-info.yaml.
+type Info struct {
+	APIVersion string   `yaml:"apiVersion"`
+	Kind       string   `yaml:"kind"`
+	Metadata   Metadata `yaml:"metadata"`
+	Spec       Spec     `yaml:"spec"`
+}
+
+// Metadata is Backstage's catalog-info.yaml metadata block.
+type Metadata struct {
+	Name        string            `yaml:"name"`
+	Annotations map[string]string `yaml:"annotations"`
+	Tags        []string          `yaml:"tags"`
+}
+
+// Spec is Backstage's catalog-info.yaml spec block, for kind: Component.
+type Spec struct {
+	Type      string `yaml:"type"`
+	Owner     string `yaml:"owner"`
+	Lifecycle string `yaml:"lifecycle"`
+}
+
+// Parse parses catalog-info.yaml content into an Info. Only kind: Component
+// is supported; any other kind is rejected since cog has nothing to do
+// with, e.g., a Backstage System or API entity. A file with no kind at all
+// is accepted and parsed as a Component.
+func Parse(data []byte) (*Info, error) {
+	var info Info
+	if err := yaml.Unmarshal(data, &info); err != nil {
+		return nil, fmt.Errorf("failed to parse catalog-info.yaml: %w", err)
+	}
+	if info.Kind != "" && info.Kind != "Component" {
+		return nil, fmt.Errorf("catalog-info.yaml: unsupported kind %q, cog only understands Component", info.Kind)
+	}
+	return &info, nil
+}
+
+// Load reads and parses the catalog-info.yaml file at path. A missing file
+// is not an error; Load returns a nil Info so callers can treat "no
+// catalog" as the normal case.
+func Load(path string) (*Info, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return Parse(data)
+}
@@ -0,0 +1,78 @@
+package catalog
+
+import "testing"
+
+func TestParseComponent(t *testing.T) {
+	data := []byte(`
+apiVersion: backstage.io/v1alpha1
+kind: Component
+metadata:
+  name: stable-diffusion
+  annotations:
+    cog.replicate.com/predict: "predict.py:Predictor"
+    cog.replicate.com/gpu: "true"
+  tags:
+    - ml
+spec:
+  type: service
+  owner: team-ml
+  lifecycle: production
+`)
+
+	info, err := Parse(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if info.Metadata.Name != "stable-diffusion" {
+		t.Fatalf("Metadata.Name = %q", info.Metadata.Name)
+	}
+	if info.Spec.Owner != "team-ml" {
+		t.Fatalf("Spec.Owner = %q", info.Spec.Owner)
+	}
+	if info.Metadata.Annotations[AnnotationPredict] != "predict.py:Predictor" {
+		t.Fatalf("missing predict annotation: %+v", info.Metadata.Annotations)
+	}
+}
+
+func TestParseRejectsUnsupportedKind(t *testing.T) {
+	data := []byte(`
+apiVersion: backstage.io/v1alpha1
+kind: System
+metadata:
+  name: ml-platform
+`)
+	if _, err := Parse(data); err == nil {
+		t.Fatal("expected an error for kind: System, got nil")
+	}
+}
+
+func TestToProjectConfig(t *testing.T) {
+	info := &Info{
+		Metadata: Metadata{
+			Annotations: map[string]string{
+				AnnotationPredict: "predict.py:Predictor",
+				AnnotationImage:   "r8.im/owner/model",
+				AnnotationGPU:     "true",
+			},
+		},
+	}
+
+	cfg := info.ToProjectConfig()
+	if cfg.Predict != "predict.py:Predictor" {
+		t.Fatalf("Predict = %q", cfg.Predict)
+	}
+	if cfg.Image != "r8.im/owner/model" {
+		t.Fatalf("Image = %q", cfg.Image)
+	}
+	if cfg.Build == nil || !cfg.Build.GPU {
+		t.Fatalf("Build = %+v", cfg.Build)
+	}
+}
+
+func TestToProjectConfigOnNilInfo(t *testing.T) {
+	var info *Info
+	cfg := info.ToProjectConfig()
+	if cfg.Predict != "" || cfg.Image != "" || cfg.Build != nil {
+		t.Fatalf("expected empty config for nil Info, got %+v", cfg)
+	}
+}
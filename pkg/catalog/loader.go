@@ -0,0 +1,40 @@
+package catalog
+
+import (
+	"path/filepath"
+
+	"github.com/replicate/cog/pkg/config"
+)
+
+// ComposeProjectConfig loads cog.yaml and catalog-info.yaml from dir and
+// merges them. cog.yaml takes precedence: any field it already sets is left
+// alone, and the catalog only fills in what cog.yaml left blank. This is
+// what `cog catalog import` writes out, and what the build/predict commands
+// can use in place of loading cog.yaml alone.
+func ComposeProjectConfig(dir string) (*config.ProjectConfig, error) {
+	projectCfg, err := config.LoadProjectConfig(filepath.Join(dir, "cog.yaml"))
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := Load(filepath.Join(dir, "catalog-info.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	if info == nil {
+		return projectCfg, nil
+	}
+
+	fromCatalog := info.ToProjectConfig()
+	if projectCfg.Predict == "" {
+		projectCfg.Predict = fromCatalog.Predict
+	}
+	if projectCfg.Image == "" {
+		projectCfg.Image = fromCatalog.Image
+	}
+	if projectCfg.Build == nil {
+		projectCfg.Build = fromCatalog.Build
+	}
+
+	return projectCfg, nil
+}
@@ -0,0 +1,82 @@
+package catalog
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestComposeProjectConfigCatalogFillsInMissingFields(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "cog.yaml", `
+image: r8.im/owner/model
+`)
+	writeFile(t, dir, "catalog-info.yaml", `
+apiVersion: backstage.io/v1alpha1
+kind: Component
+metadata:
+  name: model
+  annotations:
+    cog.replicate.com/predict: "predict.py:Predictor"
+    cog.replicate.com/gpu: "true"
+`)
+
+	cfg, err := ComposeProjectConfig(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Image != "r8.im/owner/model" {
+		t.Fatalf("Image = %q, want cog.yaml's value to win", cfg.Image)
+	}
+	if cfg.Predict != "predict.py:Predictor" {
+		t.Fatalf("Predict = %q, want catalog to fill in the missing value", cfg.Predict)
+	}
+	if cfg.Build == nil || !cfg.Build.GPU {
+		t.Fatalf("Build = %+v, want catalog to fill in build", cfg.Build)
+	}
+}
+
+func TestComposeProjectConfigNoCatalogFile(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "cog.yaml", `
+predict: "predict.py:Predictor"
+`)
+
+	cfg, err := ComposeProjectConfig(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Predict != "predict.py:Predictor" {
+		t.Fatalf("Predict = %q", cfg.Predict)
+	}
+}
+
+func TestComposeProjectConfigCogYAMLPredictWins(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "cog.yaml", `
+predict: "explicit.py:Predictor"
+`)
+	writeFile(t, dir, "catalog-info.yaml", `
+apiVersion: backstage.io/v1alpha1
+kind: Component
+metadata:
+  name: model
+  annotations:
+    cog.replicate.com/predict: "from-catalog.py:Predictor"
+`)
+
+	cfg, err := ComposeProjectConfig(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Predict != "explicit.py:Predictor" {
+		t.Fatalf("Predict = %q, want cog.yaml's explicit value to win", cfg.Predict)
+	}
+}
+
+func writeFile(t *testing.T, dir, name, contents string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(contents), 0o600); err != nil {
+		t.Fatalf("failed to write %s: %v", name, err)
+	}
+}
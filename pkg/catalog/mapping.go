@@ -0,0 +1,31 @@
+package catalog
+
+import (
+	"strconv"
+
+	"github.com/replicate/cog/pkg/config"
+)
+
+// ToProjectConfig translates a catalog Info's cog.replicate.com/*
+// annotations onto a config.ProjectConfig. Annotations that aren't present
+// leave the corresponding field zero-valued.
+func (i *Info) ToProjectConfig() *config.ProjectConfig {
+	cfg := &config.ProjectConfig{}
+	if i == nil {
+		return cfg
+	}
+
+	if predict, ok := i.Metadata.Annotations[AnnotationPredict]; ok {
+		cfg.Predict = predict
+	}
+	if image, ok := i.Metadata.Annotations[AnnotationImage]; ok {
+		cfg.Image = image
+	}
+	if gpu, ok := i.Metadata.Annotations[AnnotationGPU]; ok {
+		if enabled, err := strconv.ParseBool(gpu); err == nil {
+			cfg.Build = &config.Build{GPU: enabled}
+		}
+	}
+
+	return cfg
+}
@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/replicate/cog/pkg/catalog"
+)
+
+// NewCatalogCommand returns the `cog catalog` command group.
+func NewCatalogCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "catalog",
+		Short: "Work with a Backstage catalog-info.yaml",
+	}
+	cmd.AddCommand(newCatalogImportCommand())
+	return cmd
+}
+
+// newCatalogImportCommand returns `cog catalog import`, which composes the
+// current directory's cog.yaml and catalog-info.yaml (see pkg/catalog) and
+// writes the result back to cog.yaml.
+func newCatalogImportCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import",
+		Short: "Generate cog.yaml from catalog-info.yaml",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dir, err := os.Getwd()
+			if err != nil {
+				return err
+			}
+
+			projectCfg, err := catalog.ComposeProjectConfig(dir)
+			if err != nil {
+				return err
+			}
+
+			data, err := yaml.Marshal(projectCfg)
+			if err != nil {
+				return fmt.Errorf("failed to marshal cog.yaml: %w", err)
+			}
+
+			return os.WriteFile("cog.yaml", data, 0o644)
+		},
+	}
+}
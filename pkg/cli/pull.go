@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/replicate/cog/pkg/client"
+	"github.com/replicate/cog/pkg/config"
+	"github.com/replicate/cog/pkg/model"
+)
+
+// NewPullCommand returns the `cog pull` command.
+func NewPullCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "pull <repo>",
+		Short: "Pull a model's cog.yaml from its git provider",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := config.Load()
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+
+			repo, err := model.ParseRepo(args[0], cfg.DefaultHost)
+			if err != nil {
+				return fmt.Errorf("failed to parse repo %q: %w", args[0], err)
+			}
+
+			projectCfg, err := client.NewClientWithConfig(cfg).PullModel(cmd.Context(), &repo)
+			if err != nil {
+				return err
+			}
+
+			data, err := yaml.Marshal(projectCfg)
+			if err != nil {
+				return fmt.Errorf("failed to marshal cog.yaml: %w", err)
+			}
+
+			return os.WriteFile("cog.yaml", data, 0o644)
+		},
+	}
+}
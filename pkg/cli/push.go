@@ -0,0 +1,33 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/replicate/cog/pkg/client"
+	"github.com/replicate/cog/pkg/config"
+	"github.com/replicate/cog/pkg/model"
+)
+
+// NewPushCommand returns the `cog push` command.
+func NewPushCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "push <repo> <bundle-path>",
+		Short: "Push a built model to its git provider",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := config.Load()
+			if err != nil {
+				return fmt.Errorf("failed to load config: %w", err)
+			}
+
+			repo, err := model.ParseRepo(args[0], cfg.DefaultHost)
+			if err != nil {
+				return fmt.Errorf("failed to parse repo %q: %w", args[0], err)
+			}
+
+			return client.NewClientWithConfig(cfg).PushModel(cmd.Context(), &repo, args[1])
+		},
+	}
+}
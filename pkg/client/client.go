@@ -1,31 +1,90 @@
 package client
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"sort"
 
+	"github.com/replicate/cog/pkg/config"
 	"github.com/replicate/cog/pkg/model"
+	"github.com/replicate/cog/pkg/resolver"
 )
 
 type Client struct {
+	config     *config.Config
+	httpClient *http.Client
+	resolver   *resolver.Resolver
 }
 
+// NewClient builds a Client using the default on-disk config (see
+// pkg/config). If the config file can't be loaded, NewClient falls back to
+// an empty config so callers can still rely on COG_INTERNAL_DEFAULT_SERVER.
 func NewClient() *Client {
-	return &Client{}
+	cfg, err := config.Load()
+	if err != nil {
+		cfg = &config.Config{}
+	}
+	return NewClientWithConfig(cfg)
+}
+
+// NewClientWithConfig builds a Client using an explicitly provided config,
+// bypassing the on-disk lookup. This is mainly useful for tests.
+func NewClientWithConfig(cfg *config.Config) *Client {
+	res := resolver.New(resolveHosts(cfg))
+	res.SchemeFor = func(host string) string { return cfg.Host(host).Scheme }
+
+	return &Client{
+		config:     cfg,
+		httpClient: config.NewHTTPClient(cfg),
+		resolver:   res,
+	}
+}
+
+// resolveHosts returns the ordered list of hosts to probe when a repo names
+// a bare model with no host or user, default host first.
+func resolveHosts(cfg *config.Config) []string {
+	var hosts []string
+	for host := range cfg.Hosts {
+		if host != cfg.DefaultHost {
+			hosts = append(hosts, host)
+		}
+	}
+	sort.Strings(hosts)
+	if cfg.DefaultHost != "" {
+		hosts = append([]string{cfg.DefaultHost}, hosts...)
+	}
+	return hosts
 }
 
-func (c *Client) getURL(repo *model.Repo, path string, args ...interface{}) (string, error) {
+func (c *Client) getURL(ctx context.Context, repo *model.Repo, path string, args ...interface{}) (string, error) {
 	if len(args) > 0 {
 		path = fmt.Sprintf(path, args...)
 	}
-	var host string
-	if repo.Host != "" {
-		host = repo.Host
-	} else {
-		host = os.Getenv("COG_INTERNAL_DEFAULT_SERVER")
-		if host == "" {
-			return "", fmt.Errorf("Repo is missing host. It should be in the format 'host/user/repository'")
+
+	host := repo.Host
+	if host == "" && repo.User == "" && repo.Name != "" {
+		resolved, err := c.resolver.Lookup(ctx, repo.Name)
+		if err != nil {
+			return "", fmt.Errorf("failed to resolve host for %q: %w", repo.Name, err)
 		}
+		host = resolved
+	}
+	if host == "" {
+		host = c.config.DefaultHost
+	}
+	if host == "" {
+		host = os.Getenv("COG_INTERNAL_DEFAULT_SERVER")
 	}
-	return fmt.Sprintf("http://%s/%s", host, path), nil
-}
\ No newline at end of file
+	if host == "" {
+		return "", fmt.Errorf("repo %q is missing a host. It should be in the format 'host/user/repository'", repo.String())
+	}
+
+	scheme := c.config.Host(host).Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+
+	return fmt.Sprintf("%s://%s/%s", scheme, host, path), nil
+}
@@ -0,0 +1,110 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/replicate/cog/pkg/config"
+	"github.com/replicate/cog/pkg/model"
+)
+
+func TestGetURLUsesRepoHost(t *testing.T) {
+	c := NewClientWithConfig(&config.Config{})
+	repo := &model.Repo{Host: "registry.example.com", User: "owner", Name: "repo"}
+
+	got, err := c.getURL(context.Background(), repo, "predictions")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "https://registry.example.com/predictions"
+	if got != want {
+		t.Fatalf("getURL() = %q, want %q", got, want)
+	}
+}
+
+func TestGetURLFallsBackToConfigDefaultHost(t *testing.T) {
+	c := NewClientWithConfig(&config.Config{DefaultHost: "default.example.com"})
+	repo := &model.Repo{User: "owner", Name: "repo"}
+
+	got, err := c.getURL(context.Background(), repo, "predictions")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "https://default.example.com/predictions"
+	if got != want {
+		t.Fatalf("getURL() = %q, want %q", got, want)
+	}
+}
+
+func TestGetURLFallsBackToEnvVar(t *testing.T) {
+	t.Setenv("COG_INTERNAL_DEFAULT_SERVER", "env.example.com")
+	c := NewClientWithConfig(&config.Config{})
+	repo := &model.Repo{User: "owner", Name: "repo"}
+
+	got, err := c.getURL(context.Background(), repo, "predictions")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "https://env.example.com/predictions"
+	if got != want {
+		t.Fatalf("getURL() = %q, want %q", got, want)
+	}
+}
+
+func TestGetURLErrorsWithNoHost(t *testing.T) {
+	c := NewClientWithConfig(&config.Config{})
+	repo := &model.Repo{User: "owner", Name: "repo"}
+
+	if _, err := c.getURL(context.Background(), repo, "predictions"); err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestGetURLUsesConfiguredScheme(t *testing.T) {
+	c := NewClientWithConfig(&config.Config{
+		Hosts: map[string]config.HostConfig{
+			"internal.example.com": {Scheme: "http"},
+		},
+	})
+	repo := &model.Repo{Host: "internal.example.com", User: "owner", Name: "repo"}
+
+	got, err := c.getURL(context.Background(), repo, "predictions")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "http://internal.example.com/predictions"
+	if got != want {
+		t.Fatalf("getURL() = %q, want %q", got, want)
+	}
+}
+
+func TestGetURLResolvesBareModelName(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+	u, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatalf("failed to parse test server URL: %v", err)
+	}
+
+	c := NewClientWithConfig(&config.Config{
+		DefaultHost: u.Host,
+		Hosts: map[string]config.HostConfig{
+			u.Host: {Scheme: "http"},
+		},
+	})
+	repo := &model.Repo{Name: "stable-diffusion"}
+
+	got, err := c.getURL(context.Background(), repo, "predictions")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "http://" + u.Host + "/predictions"
+	if got != want {
+		t.Fatalf("getURL() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,213 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	gitconfig "github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	"gopkg.in/yaml.v3"
+
+	cogconfig "github.com/replicate/cog/pkg/config"
+	"github.com/replicate/cog/pkg/gitprovider"
+	"github.com/replicate/cog/pkg/model"
+)
+
+// defaultPushBranch is the branch PushModel commits to when repo.Ref is
+// empty.
+const defaultPushBranch = "main"
+
+// PushModel creates repo on its configured git provider if it doesn't
+// already exist, then commits the build artifacts and cog.yaml at
+// bundlePath to repo's branch (repo.Ref, or "main") and tags the release.
+func (c *Client) PushModel(ctx context.Context, repo *model.Repo, bundlePath string) error {
+	if repo.Host == "" {
+		return fmt.Errorf("repo %q is missing a host", repo.String())
+	}
+
+	provider, err := gitprovider.ForHost(ctx, c.config, repo.Host)
+	if err != nil {
+		return fmt.Errorf("failed to select a git provider for %s: %w", repo.Host, err)
+	}
+
+	if _, err := provider.GetRepo(ctx, repo.User, repo.Name); err != nil {
+		if _, err := provider.CreateRepo(ctx, repo.User, repo.Name, true); err != nil {
+			return fmt.Errorf("failed to create repo %s: %w", repo.String(), err)
+		}
+	}
+
+	branch := repo.Ref
+	if branch == "" {
+		branch = defaultPushBranch
+	}
+
+	auth := c.basicAuthForHost(repo.Host)
+	cloneURL := fmt.Sprintf("https://%s/%s/%s.git", repo.Host, repo.User, repo.Name)
+
+	dir, err := os.MkdirTemp("", "cog-push-*")
+	if err != nil {
+		return fmt.Errorf("failed to create a working directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	gitRepo, worktree, err := cloneOrInitBranch(ctx, dir, cloneURL, branch, auth)
+	if err != nil {
+		return err
+	}
+
+	if err := copyTree(bundlePath, dir); err != nil {
+		return fmt.Errorf("failed to stage build artifacts from %s: %w", bundlePath, err)
+	}
+	if _, err := worktree.Add("."); err != nil {
+		return fmt.Errorf("failed to stage build artifacts: %w", err)
+	}
+
+	hash, err := worktree.Commit(fmt.Sprintf("Push model build for %s", repo.String()), &git.CommitOptions{
+		Author: &object.Signature{Name: "cog", When: time.Now()},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to commit build artifacts: %w", err)
+	}
+
+	tag := fmt.Sprintf("cog-%d", time.Now().Unix())
+	if _, err := gitRepo.CreateTag(tag, hash, nil); err != nil {
+		return fmt.Errorf("failed to tag release %s: %w", tag, err)
+	}
+
+	err = gitRepo.PushContext(ctx, &git.PushOptions{
+		RemoteName: "origin",
+		Auth:       auth,
+		RefSpecs: []gitconfig.RefSpec{
+			gitconfig.RefSpec(fmt.Sprintf("refs/heads/%s:refs/heads/%s", branch, branch)),
+			gitconfig.RefSpec(fmt.Sprintf("refs/tags/%s:refs/tags/%s", tag, tag)),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to push %s to %s: %w", branch, repo.String(), err)
+	}
+
+	return nil
+}
+
+// PullModel fetches repo's cog.yaml at repo.Ref (or HEAD, if empty) from
+// its configured git provider and returns the parsed project config.
+func (c *Client) PullModel(ctx context.Context, repo *model.Repo) (*cogconfig.ProjectConfig, error) {
+	if repo.Host == "" {
+		return nil, fmt.Errorf("repo %q is missing a host", repo.String())
+	}
+
+	provider, err := gitprovider.ForHost(ctx, c.config, repo.Host)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select a git provider for %s: %w", repo.Host, err)
+	}
+
+	contents, err := provider.GetContents(ctx, repo.User, repo.Name, "cog.yaml", repo.Ref)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pull cog.yaml from %s@%s: %w", repo.String(), repo.Ref, err)
+	}
+
+	var cfg cogconfig.ProjectConfig
+	if err := yaml.Unmarshal(contents.Content, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse cog.yaml from %s@%s: %w", repo.String(), repo.Ref, err)
+	}
+	return &cfg, nil
+}
+
+func (c *Client) basicAuthForHost(host string) *githttp.BasicAuth {
+	hostCfg := c.config.Host(host)
+	if hostCfg.Token == "" {
+		return nil
+	}
+	username := hostCfg.Username
+	if username == "" {
+		username = "x-access-token"
+	}
+	return &githttp.BasicAuth{Username: username, Password: hostCfg.Token}
+}
+
+// cloneOrInitBranch clones url at branch into dir. If the remote repo
+// exists but is empty, or exists but doesn't have branch yet, it's treated
+// as a first push and dir is initialized as a fresh repo with origin set
+// to url instead. Any other error (auth failure, network error, the repo
+// not existing at all) is propagated rather than papered over.
+func cloneOrInitBranch(ctx context.Context, dir, url, branch string, auth *githttp.BasicAuth) (*git.Repository, *git.Worktree, error) {
+	repo, err := git.PlainCloneContext(ctx, dir, false, &git.CloneOptions{
+		URL:           url,
+		Auth:          auth,
+		ReferenceName: plumbing.NewBranchReferenceName(branch),
+		SingleBranch:  true,
+	})
+	if err != nil {
+		if !isFirstPush(err) {
+			return nil, nil, fmt.Errorf("failed to clone %s: %w", url, err)
+		}
+
+		repo, err = git.PlainInit(dir, false)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to initialize a repo at %s: %w", dir, err)
+		}
+		if _, err := repo.CreateRemote(&gitconfig.RemoteConfig{Name: "origin", URLs: []string{url}}); err != nil {
+			return nil, nil, fmt.Errorf("failed to add origin %s: %w", url, err)
+		}
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open worktree at %s: %w", dir, err)
+	}
+	return repo, worktree, nil
+}
+
+// isFirstPush reports whether err from a clone means "the remote repo
+// exists but has no commits on this branch yet" rather than a genuine
+// failure to reach or read it.
+func isFirstPush(err error) bool {
+	return errors.Is(err, transport.ErrEmptyRemoteRepository) ||
+		errors.Is(err, plumbing.ErrReferenceNotFound) ||
+		errors.As(err, &git.NoMatchingRefSpecError{})
+}
+
+// copyTree copies the contents of src into dst, overwriting any files that
+// already exist there.
+func copyTree(src, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if info.IsDir() {
+			return os.MkdirAll(target, 0o755)
+		}
+		return copyFile(path, target, info.Mode())
+	})
+}
+
+func copyFile(src, dst string, mode os.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	_, err = io.Copy(out, in)
+	return err
+}
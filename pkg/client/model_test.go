@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	gitconfig "github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// newBareRepo creates a bare git repo under a temp dir and returns its path,
+// suitable for use as a clone URL via go-git's local file transport.
+func newBareRepo(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	if _, err := git.PlainInit(dir, true); err != nil {
+		t.Fatalf("failed to init bare repo: %v", err)
+	}
+	return dir
+}
+
+// commitToBranch clones remote, commits a file to branch, and pushes it
+// back, so tests can seed a bare repo with history.
+func commitToBranch(t *testing.T, remote, branch string) {
+	t.Helper()
+	dir := t.TempDir()
+	repo, err := git.PlainInit(dir, false)
+	if err != nil {
+		t.Fatalf("failed to init seed repo: %v", err)
+	}
+	if _, err := repo.CreateRemote(&gitconfig.RemoteConfig{Name: "origin", URLs: []string{remote}}); err != nil {
+		t.Fatalf("failed to add origin: %v", err)
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		t.Fatalf("failed to open worktree: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "cog.yaml"), []byte("build: {}\n"), 0o644); err != nil {
+		t.Fatalf("failed to write seed file: %v", err)
+	}
+	if _, err := worktree.Add("."); err != nil {
+		t.Fatalf("failed to stage seed file: %v", err)
+	}
+	if _, err := worktree.Commit("seed", &git.CommitOptions{
+		Author: &object.Signature{Name: "test", When: time.Now()},
+	}); err != nil {
+		t.Fatalf("failed to commit seed file: %v", err)
+	}
+
+	refSpec := gitconfig.RefSpec("refs/heads/master:refs/heads/" + branch)
+	if err := repo.PushContext(context.Background(), &git.PushOptions{RefSpecs: []gitconfig.RefSpec{refSpec}}); err != nil {
+		t.Fatalf("failed to push seed commit: %v", err)
+	}
+}
+
+func TestCloneOrInitBranchInitializesOnEmptyRepo(t *testing.T) {
+	remote := newBareRepo(t)
+	dir := t.TempDir()
+
+	repo, worktree, err := cloneOrInitBranch(context.Background(), dir, remote, "main", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repo == nil || worktree == nil {
+		t.Fatal("expected a non-nil repo and worktree")
+	}
+
+	if _, err := repo.Remote("origin"); err != nil {
+		t.Fatalf("expected an origin remote to be configured: %v", err)
+	}
+}
+
+func TestCloneOrInitBranchInitializesWhenBranchMissing(t *testing.T) {
+	remote := newBareRepo(t)
+	commitToBranch(t, remote, "other-branch")
+	dir := t.TempDir()
+
+	_, worktree, err := cloneOrInitBranch(context.Background(), dir, remote, "main", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := worktree.Filesystem.Stat("cog.yaml"); err == nil {
+		t.Fatal("expected a fresh worktree with no files from other-branch")
+	}
+}
+
+func TestCloneOrInitBranchClonesExistingBranch(t *testing.T) {
+	remote := newBareRepo(t)
+	commitToBranch(t, remote, "main")
+	dir := t.TempDir()
+
+	repo, worktree, err := cloneOrInitBranch(context.Background(), dir, remote, "main", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := worktree.Filesystem.Stat("cog.yaml"); err != nil {
+		t.Fatalf("expected the existing branch's history to be preserved: %v", err)
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		t.Fatalf("failed to resolve HEAD: %v", err)
+	}
+	if head.Name() != plumbing.NewBranchReferenceName("main") {
+		t.Fatalf("HEAD = %s, want refs/heads/main", head.Name())
+	}
+}
+
+func TestCloneOrInitBranchPropagatesUnrelatedErrors(t *testing.T) {
+	dir := t.TempDir()
+
+	_, _, err := cloneOrInitBranch(context.Background(), dir, filepath.Join(t.TempDir(), "does-not-exist"), "main", nil)
+	if err == nil {
+		t.Fatal("expected an error for a remote that doesn't exist, got nil")
+	}
+}
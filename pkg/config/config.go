@@ -0,0 +1,83 @@
+// Package config loads cog's user-level configuration: the set of known
+// hosts, their auth tokens and TLS settings, and which host to use when a
+// repo spec doesn't name one.
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// envConfigPath, when set, overrides the default config file location.
+const envConfigPath = "COG_CONFIG"
+
+// HostConfig holds the settings cog needs to talk to a single host.
+type HostConfig struct {
+	Token              string `yaml:"token"`
+	Scheme             string `yaml:"scheme"`
+	InsecureSkipVerify bool   `yaml:"insecure_skip_verify"`
+	CACert             string `yaml:"ca_cert"`
+
+	// Provider selects the pkg/gitprovider backend to use for this host,
+	// e.g. "github". Left empty, hosts other than github.com use the
+	// generic git-over-HTTPS backend.
+	Provider string `yaml:"provider"`
+	// Username is the git-over-HTTPS basic-auth username to pair with
+	// Token. Most PAT-based hosts (including GitHub) ignore it and accept
+	// any non-empty value.
+	Username string `yaml:"username"`
+}
+
+// Config is the shape of ~/.config/cog/config.yaml.
+type Config struct {
+	DefaultHost string                `yaml:"default_host"`
+	Hosts       map[string]HostConfig `yaml:"hosts"`
+}
+
+// Host returns the configuration for host, or a zero-value HostConfig if
+// the host isn't configured.
+func (c *Config) Host(host string) HostConfig {
+	if c == nil {
+		return HostConfig{}
+	}
+	return c.Hosts[host]
+}
+
+// Path returns the path to the config file, honoring $COG_CONFIG.
+func Path() (string, error) {
+	if p := os.Getenv(envConfigPath); p != "" {
+		return p, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to determine home directory: %w", err)
+	}
+	return filepath.Join(home, ".config", "cog", "config.yaml"), nil
+}
+
+// Load reads and parses the config file. A missing file is not an error;
+// Load returns an empty Config in that case so callers can fall back to
+// other defaults (env vars, flags).
+func Load() (*Config, error) {
+	path, err := Path()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &Config{}, nil
+		}
+		return nil, fmt.Errorf("failed to read config file %s: %w", path, err)
+	}
+
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+	return &cfg, nil
+}
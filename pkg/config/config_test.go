@@ -0,0 +1,73 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadMissingFileReturnsEmptyConfig(t *testing.T) {
+	t.Setenv(envConfigPath, filepath.Join(t.TempDir(), "does-not-exist.yaml"))
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.DefaultHost != "" || len(cfg.Hosts) != 0 {
+		t.Fatalf("expected empty config, got %+v", cfg)
+	}
+}
+
+func TestLoadParsesHostsAndDefaultHost(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	contents := `
+default_host: registry.example.com
+hosts:
+  registry.example.com:
+    token: abc123
+    scheme: https
+  internal.example.com:
+    token: def456
+    insecure_skip_verify: true
+    ca_cert: /etc/ssl/internal.pem
+`
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatalf("failed to write test config: %v", err)
+	}
+	t.Setenv(envConfigPath, path)
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.DefaultHost != "registry.example.com" {
+		t.Fatalf("DefaultHost = %q, want %q", cfg.DefaultHost, "registry.example.com")
+	}
+
+	got := cfg.Host("registry.example.com")
+	want := HostConfig{Token: "abc123", Scheme: "https"}
+	if got != want {
+		t.Fatalf("Host(registry.example.com) = %+v, want %+v", got, want)
+	}
+
+	internal := cfg.Host("internal.example.com")
+	if internal.Token != "def456" || !internal.InsecureSkipVerify || internal.CACert != "/etc/ssl/internal.pem" {
+		t.Fatalf("Host(internal.example.com) = %+v", internal)
+	}
+
+	if unknown := cfg.Host("unknown.example.com"); unknown != (HostConfig{}) {
+		t.Fatalf("Host(unknown.example.com) = %+v, want zero value", unknown)
+	}
+}
+
+func TestLoadRejectsMalformedYAML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("default_host: [not valid"), 0o600); err != nil {
+		t.Fatalf("failed to write test config: %v", err)
+	}
+	t.Setenv(envConfigPath, path)
+
+	if _, err := Load(); err == nil {
+		t.Fatal("expected an error parsing malformed config, got nil")
+	}
+}
@@ -0,0 +1,43 @@
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ProjectConfig is the schema of a project's cog.yaml: how to build the
+// model's environment and what to predict with.
+type ProjectConfig struct {
+	Build   *Build `yaml:"build,omitempty"`
+	Image   string `yaml:"image,omitempty"`
+	Predict string `yaml:"predict,omitempty"`
+}
+
+// Build describes the environment cog should build the model's image in.
+type Build struct {
+	GPU            bool     `yaml:"gpu,omitempty"`
+	PythonVersion  string   `yaml:"python_version,omitempty"`
+	PythonPackages []string `yaml:"python_packages,omitempty"`
+	SystemPackages []string `yaml:"system_packages,omitempty"`
+}
+
+// LoadProjectConfig reads and parses a cog.yaml file at path. A missing
+// file is not an error; LoadProjectConfig returns an empty ProjectConfig so
+// callers can compose it with other sources (see pkg/catalog).
+func LoadProjectConfig(path string) (*ProjectConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &ProjectConfig{}, nil
+		}
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	var cfg ProjectConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	return &cfg, nil
+}
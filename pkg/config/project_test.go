@@ -0,0 +1,47 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadProjectConfigMissingFileReturnsEmptyConfig(t *testing.T) {
+	cfg, err := LoadProjectConfig(filepath.Join(t.TempDir(), "cog.yaml"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Predict != "" || cfg.Image != "" || cfg.Build != nil {
+		t.Fatalf("expected empty config, got %+v", cfg)
+	}
+}
+
+func TestLoadProjectConfigParsesBuildAndPredict(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cog.yaml")
+	contents := `
+predict: "predict.py:Predictor"
+image: "r8.im/owner/model"
+build:
+  gpu: true
+  python_version: "3.11"
+  python_packages:
+    - torch==2.1.0
+`
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatalf("failed to write test cog.yaml: %v", err)
+	}
+
+	cfg, err := LoadProjectConfig(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Predict != "predict.py:Predictor" {
+		t.Fatalf("Predict = %q", cfg.Predict)
+	}
+	if cfg.Image != "r8.im/owner/model" {
+		t.Fatalf("Image = %q", cfg.Image)
+	}
+	if cfg.Build == nil || !cfg.Build.GPU || cfg.Build.PythonVersion != "3.11" {
+		t.Fatalf("Build = %+v", cfg.Build)
+	}
+}
@@ -0,0 +1,126 @@
+package config
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func hostOf(t *testing.T, rawURL string) string {
+	t.Helper()
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		t.Fatalf("failed to parse test server URL: %v", err)
+	}
+	return u.Host
+}
+
+func TestRoundTripSetsAuthorizationOnlyForMatchingHost(t *testing.T) {
+	var gotAuth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(other.Close)
+
+	cfg := &Config{Hosts: map[string]HostConfig{
+		hostOf(t, srv.URL): {Token: "tok123"},
+	}}
+	httpClient := NewHTTPClient(cfg)
+
+	if _, err := httpClient.Get(srv.URL); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "Bearer tok123"; gotAuth != want {
+		t.Fatalf("Authorization = %q, want %q", gotAuth, want)
+	}
+
+	if _, err := httpClient.Get(other.URL); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotAuth != "" {
+		t.Fatalf("Authorization = %q, want none for an unconfigured host", gotAuth)
+	}
+}
+
+func TestRoundTripRejectsUntrustedCertByDefault(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	cfg := &Config{}
+	httpClient := NewHTTPClient(cfg)
+
+	if _, err := httpClient.Get(srv.URL); err == nil {
+		t.Fatal("expected an error for an untrusted cert, got nil")
+	}
+}
+
+func TestRoundTripHonorsInsecureSkipVerify(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	cfg := &Config{Hosts: map[string]HostConfig{
+		hostOf(t, srv.URL): {InsecureSkipVerify: true},
+	}}
+	httpClient := NewHTTPClient(cfg)
+
+	resp, err := httpClient.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+}
+
+func TestRoundTripHonorsCACert(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	caCertPath := filepath.Join(t.TempDir(), "ca.pem")
+	pemBytes := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	if err := os.WriteFile(caCertPath, pemBytes, 0o600); err != nil {
+		t.Fatalf("failed to write ca cert: %v", err)
+	}
+
+	cfg := &Config{Hosts: map[string]HostConfig{
+		hostOf(t, srv.URL): {CACert: caCertPath},
+	}}
+	httpClient := NewHTTPClient(cfg)
+
+	resp, err := httpClient.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+}
+
+func TestRoundTripErrorsOnUnreadableCACert(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	cfg := &Config{Hosts: map[string]HostConfig{
+		hostOf(t, srv.URL): {CACert: filepath.Join(t.TempDir(), "does-not-exist.pem")},
+	}}
+	httpClient := NewHTTPClient(cfg)
+
+	if _, err := httpClient.Get(srv.URL); err == nil {
+		t.Fatal("expected an error for a missing ca_cert, got nil")
+	}
+}
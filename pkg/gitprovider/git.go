@@ -0,0 +1,129 @@
+package gitprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/go-git/go-git/v5/storage/memory"
+)
+
+// GitProvider implements Provider against a generic git-over-HTTPS remote
+// that has no dedicated REST API (i.e. isn't GitHub). It has no way to
+// create repos server-side or look up user identity, so those methods
+// return an error rather than guessing.
+type GitProvider struct {
+	host string
+	auth *http.BasicAuth
+}
+
+// NewGitProvider builds a GitProvider that authenticates to host using
+// git's conventional basic-auth-with-a-PAT.
+func NewGitProvider(host, username, token string) *GitProvider {
+	return &GitProvider{
+		host: host,
+		auth: &http.BasicAuth{Username: username, Password: token},
+	}
+}
+
+func (p *GitProvider) cloneURL(owner, name string) string {
+	return fmt.Sprintf("https://%s/%s/%s.git", p.host, owner, name)
+}
+
+// CreateRepo is unsupported: a generic git-over-HTTPS remote has no API to
+// create repos server-side. Create the remote out-of-band, or use the
+// GitHub provider.
+func (p *GitProvider) CreateRepo(ctx context.Context, owner, name string, private bool) (*RepoInfo, error) {
+	return nil, fmt.Errorf("gitprovider: generic git-over-HTTPS backend cannot create repos; create %s/%s on %s out-of-band first", owner, name, p.host)
+}
+
+func (p *GitProvider) GetRepo(ctx context.Context, owner, name string) (*RepoInfo, error) {
+	url := p.cloneURL(owner, name)
+	repo, err := git.CloneContext(ctx, memory.NewStorage(), nil, &git.CloneOptions{
+		URL:   url,
+		Auth:  p.auth,
+		Depth: 1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach %s: %w", url, err)
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve HEAD of %s: %w", url, err)
+	}
+
+	return &RepoInfo{
+		Name:          name,
+		Owner:         owner,
+		DefaultBranch: head.Name().Short(),
+		CloneURL:      url,
+	}, nil
+}
+
+// GetContents fetches path as of ref, where ref may be a branch name, a tag
+// name, or a commit SHA (or empty, for HEAD).
+func (p *GitProvider) GetContents(ctx context.Context, owner, name, path, ref string) (*Contents, error) {
+	url := p.cloneURL(owner, name)
+
+	// ref can name a branch, a tag, or a commit, so clone the full history
+	// rather than guess which kind of reference it is up front.
+	repo, err := git.CloneContext(ctx, memory.NewStorage(), nil, &git.CloneOptions{
+		URL:  url,
+		Auth: p.auth,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach %s: %w", url, err)
+	}
+
+	hash, err := resolveRevision(repo, ref)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve %q in %s: %w", ref, url, err)
+	}
+
+	commit, err := repo.CommitObject(*hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load commit %s in %s: %w", hash, url, err)
+	}
+
+	file, err := commit.File(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s at %s in %s: %w", path, commit.Hash, url, err)
+	}
+
+	content, err := file.Contents()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s at %s in %s: %w", path, commit.Hash, url, err)
+	}
+
+	return &Contents{Path: path, SHA: commit.Hash.String(), Content: []byte(content)}, nil
+}
+
+// resolveRevision resolves ref (a branch, tag, or commit SHA) to a commit
+// hash, defaulting to HEAD when ref is empty.
+func resolveRevision(repo *git.Repository, ref string) (*plumbing.Hash, error) {
+	if ref == "" {
+		head, err := repo.Head()
+		if err != nil {
+			return nil, err
+		}
+		hash := head.Hash()
+		return &hash, nil
+	}
+	return repo.ResolveRevision(plumbing.Revision(ref))
+}
+
+// AuthenticatedUser is unsupported: generic git-over-HTTPS has no user
+// identity API.
+func (p *GitProvider) AuthenticatedUser(ctx context.Context) (*User, error) {
+	return nil, fmt.Errorf("gitprovider: generic git-over-HTTPS backend has no user identity API")
+}
+
+// CheckTokenScopes is unsupported for the same reason: there's no API to
+// introspect a credential's scopes, only whether a given operation
+// succeeds or fails.
+func (p *GitProvider) CheckTokenScopes(ctx context.Context, scopes ...string) error {
+	return fmt.Errorf("gitprovider: generic git-over-HTTPS backend cannot introspect token scopes")
+}
@@ -0,0 +1,99 @@
+package gitprovider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/memory"
+)
+
+// newRepoWithCommits builds an in-memory repo with two commits on master: the
+// first tagged "v1", the second left as HEAD. It returns the repo and both
+// commit hashes.
+func newRepoWithCommits(t *testing.T) (repo *git.Repository, first, second plumbing.Hash) {
+	t.Helper()
+	repo, err := git.Init(memory.NewStorage(), memfs.New())
+	if err != nil {
+		t.Fatalf("failed to init repo: %v", err)
+	}
+	worktree, err := repo.Worktree()
+	if err != nil {
+		t.Fatalf("failed to open worktree: %v", err)
+	}
+
+	sig := &object.Signature{Name: "test", When: time.Now()}
+	first, err = worktree.Commit("first", &git.CommitOptions{Author: sig, AllowEmptyCommits: true})
+	if err != nil {
+		t.Fatalf("failed to create first commit: %v", err)
+	}
+	if _, err := repo.CreateTag("v1", first, nil); err != nil {
+		t.Fatalf("failed to tag first commit: %v", err)
+	}
+
+	second, err = worktree.Commit("second", &git.CommitOptions{Author: sig, AllowEmptyCommits: true})
+	if err != nil {
+		t.Fatalf("failed to create second commit: %v", err)
+	}
+
+	return repo, first, second
+}
+
+func TestResolveRevisionDefaultsToHEADWhenRefIsEmpty(t *testing.T) {
+	repo, _, second := newRepoWithCommits(t)
+
+	hash, err := resolveRevision(repo, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if *hash != second {
+		t.Fatalf("resolveRevision(\"\") = %s, want %s", hash, second)
+	}
+}
+
+func TestResolveRevisionResolvesBranchName(t *testing.T) {
+	repo, _, second := newRepoWithCommits(t)
+
+	hash, err := resolveRevision(repo, "master")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if *hash != second {
+		t.Fatalf("resolveRevision(\"master\") = %s, want %s", hash, second)
+	}
+}
+
+func TestResolveRevisionResolvesTagName(t *testing.T) {
+	repo, first, _ := newRepoWithCommits(t)
+
+	hash, err := resolveRevision(repo, "v1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if *hash != first {
+		t.Fatalf("resolveRevision(\"v1\") = %s, want %s", hash, first)
+	}
+}
+
+func TestResolveRevisionResolvesCommitSHA(t *testing.T) {
+	repo, first, _ := newRepoWithCommits(t)
+
+	hash, err := resolveRevision(repo, first.String())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if *hash != first {
+		t.Fatalf("resolveRevision(%s) = %s, want %s", first, hash, first)
+	}
+}
+
+func TestResolveRevisionErrorsOnUnknownRef(t *testing.T) {
+	repo, _, _ := newRepoWithCommits(t)
+
+	if _, err := resolveRevision(repo, "does-not-exist"); err == nil {
+		t.Fatal("expected an error for an unknown ref, got nil")
+	}
+}
@@ -0,0 +1,107 @@
+package gitprovider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-github/v58/github"
+	"golang.org/x/oauth2"
+)
+
+// GitHubProvider implements Provider against github.com or a GitHub
+// Enterprise host, via the GitHub REST API.
+type GitHubProvider struct {
+	client *github.Client
+}
+
+// NewGitHubProvider builds a GitHubProvider authenticated with token.
+// baseURL is the GitHub Enterprise API base URL, or empty for github.com.
+func NewGitHubProvider(ctx context.Context, token, baseURL string) (*GitHubProvider, error) {
+	httpClient := oauth2.NewClient(ctx, oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token}))
+
+	client := github.NewClient(httpClient)
+	if baseURL != "" {
+		var err error
+		client, err = client.WithEnterpriseURLs(baseURL, baseURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build GitHub Enterprise client for %s: %w", baseURL, err)
+		}
+	}
+
+	return &GitHubProvider{client: client}, nil
+}
+
+func (p *GitHubProvider) CreateRepo(ctx context.Context, owner, name string, private bool) (*RepoInfo, error) {
+	repo, _, err := p.client.Repositories.Create(ctx, owner, &github.Repository{
+		Name:    github.String(name),
+		Private: github.Bool(private),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create repo %s/%s: %w", owner, name, err)
+	}
+	return toRepoInfo(repo), nil
+}
+
+func (p *GitHubProvider) GetRepo(ctx context.Context, owner, name string) (*RepoInfo, error) {
+	repo, _, err := p.client.Repositories.Get(ctx, owner, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repo %s/%s: %w", owner, name, err)
+	}
+	return toRepoInfo(repo), nil
+}
+
+func (p *GitHubProvider) GetContents(ctx context.Context, owner, name, path, ref string) (*Contents, error) {
+	fileContent, _, _, err := p.client.Repositories.GetContents(ctx, owner, name, path, &github.RepositoryContentGetOptions{Ref: ref})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s from %s/%s@%s: %w", path, owner, name, ref, err)
+	}
+	content, err := fileContent.GetContent()
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s from %s/%s@%s: %w", path, owner, name, ref, err)
+	}
+	return &Contents{Path: path, SHA: fileContent.GetSHA(), Content: []byte(content)}, nil
+}
+
+func (p *GitHubProvider) AuthenticatedUser(ctx context.Context) (*User, error) {
+	user, _, err := p.client.Users.Get(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get authenticated user: %w", err)
+	}
+	return &User{Login: user.GetLogin(), Email: user.GetEmail()}, nil
+}
+
+func (p *GitHubProvider) CheckTokenScopes(ctx context.Context, scopes ...string) error {
+	_, resp, err := p.client.Users.Get(ctx, "")
+	if err != nil {
+		return fmt.Errorf("failed to check token scopes: %w", err)
+	}
+
+	granted := map[string]bool{}
+	for _, scope := range strings.Split(resp.Header.Get("X-OAuth-Scopes"), ",") {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			granted[scope] = true
+		}
+	}
+
+	var missing []string
+	for _, scope := range scopes {
+		if !granted[scope] {
+			missing = append(missing, scope)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("token is missing required scopes: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+func toRepoInfo(repo *github.Repository) *RepoInfo {
+	return &RepoInfo{
+		Name:          repo.GetName(),
+		Owner:         repo.GetOwner().GetLogin(),
+		DefaultBranch: repo.GetDefaultBranch(),
+		CloneURL:      repo.GetCloneURL(),
+		Private:       repo.GetPrivate(),
+	}
+}
@@ -0,0 +1,39 @@
+// Package gitprovider abstracts the git hosting backend cog pushes and
+// pulls model repos against, so cog isn't hard-wired to its own internal
+// registry.
+package gitprovider
+
+import "context"
+
+// RepoInfo is the repo metadata a Provider returns.
+type RepoInfo struct {
+	Name          string
+	Owner         string
+	DefaultBranch string
+	CloneURL      string
+	Private       bool
+}
+
+// Contents is a single file fetched from a repo at a ref.
+type Contents struct {
+	Path    string
+	SHA     string
+	Content []byte
+}
+
+// User is the identity a provider's credentials belong to.
+type User struct {
+	Login string
+	Email string
+}
+
+// Provider is cog's abstraction over a git hosting backend: enough to
+// create a model's repo, read it back, and check what its credentials are
+// allowed to do.
+type Provider interface {
+	CreateRepo(ctx context.Context, owner, name string, private bool) (*RepoInfo, error)
+	GetRepo(ctx context.Context, owner, name string) (*RepoInfo, error)
+	GetContents(ctx context.Context, owner, name, path, ref string) (*Contents, error)
+	AuthenticatedUser(ctx context.Context) (*User, error)
+	CheckTokenScopes(ctx context.Context, scopes ...string) error
+}
@@ -0,0 +1,38 @@
+package gitprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/replicate/cog/pkg/config"
+)
+
+var (
+	_ Provider = (*GitHubProvider)(nil)
+	_ Provider = (*GitProvider)(nil)
+)
+
+// ForHost returns the Provider to use for host, selected from cfg. github.com,
+// and any host whose config sets provider: github, use the GitHub API;
+// everything else falls back to generic git-over-HTTPS.
+func ForHost(ctx context.Context, cfg *config.Config, host string) (Provider, error) {
+	if host == "" {
+		return nil, fmt.Errorf("gitprovider: host is required")
+	}
+
+	hostCfg := cfg.Host(host)
+
+	if host == "github.com" || hostCfg.Provider == "github" {
+		baseURL := ""
+		if host != "github.com" {
+			baseURL = fmt.Sprintf("https://%s/", host)
+		}
+		return NewGitHubProvider(ctx, hostCfg.Token, baseURL)
+	}
+
+	username := hostCfg.Username
+	if username == "" {
+		username = "x-access-token"
+	}
+	return NewGitProvider(host, username, hostCfg.Token), nil
+}
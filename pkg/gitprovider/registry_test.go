@@ -0,0 +1,49 @@
+package gitprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/replicate/cog/pkg/config"
+)
+
+func TestForHostSelectsGitHubForGitHubDotCom(t *testing.T) {
+	provider, err := ForHost(context.Background(), &config.Config{}, "github.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := provider.(*GitHubProvider); !ok {
+		t.Fatalf("ForHost(github.com) = %T, want *GitHubProvider", provider)
+	}
+}
+
+func TestForHostSelectsGitHubWhenConfigured(t *testing.T) {
+	cfg := &config.Config{
+		Hosts: map[string]config.HostConfig{
+			"ghe.example.com": {Provider: "github", Token: "tok"},
+		},
+	}
+	provider, err := ForHost(context.Background(), cfg, "ghe.example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := provider.(*GitHubProvider); !ok {
+		t.Fatalf("ForHost(ghe.example.com) = %T, want *GitHubProvider", provider)
+	}
+}
+
+func TestForHostDefaultsToGenericGit(t *testing.T) {
+	provider, err := ForHost(context.Background(), &config.Config{}, "gitlab.example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := provider.(*GitProvider); !ok {
+		t.Fatalf("ForHost(gitlab.example.com) = %T, want *GitProvider", provider)
+	}
+}
+
+func TestForHostRequiresHost(t *testing.T) {
+	if _, err := ForHost(context.Background(), &config.Config{}, ""); err == nil {
+		t.Fatal("expected an error for an empty host, got nil")
+	}
+}
@@ -0,0 +1,105 @@
+package model
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Repo identifies a model's location: the host it's registered on, the
+// owning user or organization, and its name. Ref and SubPath are optional
+// and are populated when the repo spec carries a `@ref` and/or `:subpath`
+// suffix, similar to how kustomize decomposes a git repo URL.
+type Repo struct {
+	Host    string
+	User    string
+	Name    string
+	Ref     string
+	SubPath string
+}
+
+// String renders the repo back into "host/user/name" form, omitting the
+// host if it's empty.
+func (r Repo) String() string {
+	if r.Host == "" {
+		return fmt.Sprintf("%s/%s", r.User, r.Name)
+	}
+	return fmt.Sprintf("%s/%s/%s", r.Host, r.User, r.Name)
+}
+
+// ParseRepo parses a repo spec in any of the following forms:
+//
+//	https://host/owner/repo[.git]
+//	host/owner/repo
+//	owner/repo
+//
+// Any of these may be suffixed with a ref and/or subpath, e.g.
+// "owner/repo@v1.2.3:models/foo". If the spec doesn't specify a host,
+// defaultHost is used; if defaultHost is empty, ParseRepo returns an error.
+func ParseRepo(spec string, defaultHost string) (Repo, error) {
+	if strings.TrimSpace(spec) == "" {
+		return Repo{}, fmt.Errorf("repo spec is empty")
+	}
+
+	var host, rest, ref, subPath string
+	switch {
+	case strings.Contains(spec, "://"):
+		u, err := url.Parse(spec)
+		if err != nil {
+			return Repo{}, fmt.Errorf("invalid repo URL %q: %w", spec, err)
+		}
+		if u.Host == "" {
+			return Repo{}, fmt.Errorf("invalid repo URL %q: missing host", spec)
+		}
+		host = u.Host
+		path, r, sp := splitRefAndSubPath(strings.Trim(u.Path, "/"))
+		rest, ref, subPath = path, r, sp
+	default:
+		path, r, sp := splitRefAndSubPath(spec)
+		ref, subPath = r, sp
+
+		parts := strings.Split(path, "/")
+		switch len(parts) {
+		case 2:
+			host = defaultHost
+			rest = path
+		case 3:
+			host = parts[0]
+			rest = strings.Join(parts[1:], "/")
+		default:
+			return Repo{}, fmt.Errorf("repo spec %q should be in the format 'host/user/repository' or 'user/repository'", spec)
+		}
+	}
+
+	rest = strings.TrimSuffix(rest, ".git")
+	parts := strings.Split(rest, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return Repo{}, fmt.Errorf("repo spec %q should be in the format 'host/user/repository' or 'user/repository'", spec)
+	}
+
+	if host == "" {
+		return Repo{}, fmt.Errorf("repo spec %q is missing a host and no default host was provided", spec)
+	}
+
+	return Repo{
+		Host:    host,
+		User:    parts[0],
+		Name:    parts[1],
+		Ref:     ref,
+		SubPath: subPath,
+	}, nil
+}
+
+// splitRefAndSubPath peels a trailing "@ref" and/or ":subpath" off a repo
+// spec, e.g. "owner/repo@v1.2.3:models/foo" -> ("owner/repo", "v1.2.3", "models/foo").
+// The ref, if present, must come before the subpath.
+func splitRefAndSubPath(spec string) (rest, ref, subPath string) {
+	rest = spec
+	if i := strings.Index(rest, ":"); i >= 0 {
+		rest, subPath = rest[:i], rest[i+1:]
+	}
+	if i := strings.Index(rest, "@"); i >= 0 {
+		rest, ref = rest[:i], rest[i+1:]
+	}
+	return rest, ref, subPath
+}
@@ -0,0 +1,94 @@
+package model
+
+import "testing"
+
+func TestParseRepo(t *testing.T) {
+	cases := []struct {
+		name        string
+		spec        string
+		defaultHost string
+		want        Repo
+		wantErr     bool
+	}{
+		{
+			name: "url",
+			spec: "https://example.com/owner/repo",
+			want: Repo{Host: "example.com", User: "owner", Name: "repo"},
+		},
+		{
+			name: "url with .git suffix",
+			spec: "https://example.com/owner/repo.git",
+			want: Repo{Host: "example.com", User: "owner", Name: "repo"},
+		},
+		{
+			name: "url with ref and subpath",
+			spec: "https://example.com/owner/repo@v1.2.3:models/foo",
+			want: Repo{Host: "example.com", User: "owner", Name: "repo", Ref: "v1.2.3", SubPath: "models/foo"},
+		},
+		{
+			name: "host-prefixed shorthand",
+			spec: "example.com/owner/repo",
+			want: Repo{Host: "example.com", User: "owner", Name: "repo"},
+		},
+		{
+			name:        "bare shorthand uses default host",
+			spec:        "owner/repo",
+			defaultHost: "default.example.com",
+			want:        Repo{Host: "default.example.com", User: "owner", Name: "repo"},
+		},
+		{
+			name:    "bare shorthand with no default host errors",
+			spec:    "owner/repo",
+			wantErr: true,
+		},
+		{
+			name: "ref and subpath",
+			spec: "example.com/owner/repo@v1.2.3:models/foo",
+			want: Repo{Host: "example.com", User: "owner", Name: "repo", Ref: "v1.2.3", SubPath: "models/foo"},
+		},
+		{
+			name:        "ref only",
+			spec:        "owner/repo@main",
+			defaultHost: "default.example.com",
+			want:        Repo{Host: "default.example.com", User: "owner", Name: "repo", Ref: "main"},
+		},
+		{
+			name:    "empty spec",
+			spec:    "",
+			wantErr: true,
+		},
+		{
+			name:    "too many path segments",
+			spec:    "a/b/c/d",
+			wantErr: true,
+		},
+		{
+			name:    "missing repo name",
+			spec:    "owner/",
+			wantErr: true,
+		},
+		{
+			name:    "malformed url",
+			spec:    "https:///owner/repo",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseRepo(tc.spec, tc.defaultHost)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("ParseRepo(%q, %q) = %+v, want error", tc.spec, tc.defaultHost, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseRepo(%q, %q) returned unexpected error: %v", tc.spec, tc.defaultHost, err)
+			}
+			if got != tc.want {
+				t.Fatalf("ParseRepo(%q, %q) = %+v, want %+v", tc.spec, tc.defaultHost, got, tc.want)
+			}
+		})
+	}
+}
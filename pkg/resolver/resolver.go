@@ -0,0 +1,196 @@
+// Package resolver lets users refer to a model by a bare name (e.g.
+// "stable-diffusion") and resolves it against a configured, ordered list of
+// hosts by probing each one in turn. Resolutions are cached so repeated
+// lookups for the same name don't re-probe every host.
+package resolver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// DefaultExpireAfter is how long a successful resolution stays cached.
+	DefaultExpireAfter = 24 * time.Hour
+	// DefaultNegativeExpireAfter is how long a failed resolution stays
+	// cached. It's kept short so a model created after a failed lookup
+	// becomes resolvable again quickly.
+	DefaultNegativeExpireAfter = time.Minute
+	// DefaultUpstreamTimeout bounds how long a single probe of a
+	// candidate host may take.
+	DefaultUpstreamTimeout = 5 * time.Second
+)
+
+// cacheEntry is the cached outcome of resolving a name, positive or
+// negative.
+type cacheEntry struct {
+	host       string
+	found      bool
+	resolvedAt time.Time
+}
+
+func (e cacheEntry) expired(now time.Time, expireAfter, negativeExpireAfter time.Duration) bool {
+	ttl := expireAfter
+	if !e.found {
+		ttl = negativeExpireAfter
+	}
+	return now.Sub(e.resolvedAt) >= ttl
+}
+
+// Resolver resolves a bare model name to the first host, among Hosts, that
+// returns a successful HEAD response for the model's endpoint.
+type Resolver struct {
+	// Hosts is the ordered list of candidate hosts to probe.
+	Hosts []string
+	// ExpireAfter is how long a successful resolution is cached.
+	ExpireAfter time.Duration
+	// NegativeExpireAfter is how long a failed resolution is cached.
+	NegativeExpireAfter time.Duration
+	// UpstreamTimeout bounds each per-host probe.
+	UpstreamTimeout time.Duration
+	// HTTPClient is used to probe candidate hosts. Defaults to
+	// http.DefaultClient.
+	HTTPClient *http.Client
+	// SchemeFor returns the URL scheme to probe host with. If nil, or if
+	// it returns "", "https" is used.
+	SchemeFor func(host string) string
+
+	mu    sync.Mutex
+	cache map[string]cacheEntry
+	group singleflight.Group
+}
+
+// New returns a Resolver that probes hosts in order, with the package's
+// default TTLs and timeout.
+func New(hosts []string) *Resolver {
+	return &Resolver{
+		Hosts:               hosts,
+		ExpireAfter:         DefaultExpireAfter,
+		NegativeExpireAfter: DefaultNegativeExpireAfter,
+		UpstreamTimeout:     DefaultUpstreamTimeout,
+		HTTPClient:          http.DefaultClient,
+		cache:               map[string]cacheEntry{},
+	}
+}
+
+// Lookup resolves name to a host, consulting the cache first and
+// coalescing concurrent lookups for the same name into a single probe
+// sequence.
+func (r *Resolver) Lookup(ctx context.Context, name string) (string, error) {
+	if entry, ok := r.cached(name); ok {
+		if entry.found {
+			return entry.host, nil
+		}
+		return "", fmt.Errorf("model %q was not found on any configured host", name)
+	}
+
+	v, err, _ := r.group.Do(name, func() (interface{}, error) {
+		// Another caller may have populated the cache while we waited to
+		// enter the singleflight group.
+		if entry, ok := r.cached(name); ok {
+			if entry.found {
+				return entry.host, nil
+			}
+			return nil, fmt.Errorf("model %q was not found on any configured host", name)
+		}
+
+		host, found, err := r.probeHosts(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		r.store(name, cacheEntry{host: host, found: found, resolvedAt: time.Now()})
+		if !found {
+			return nil, fmt.Errorf("model %q was not found on any configured host", name)
+		}
+		return host, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return v.(string), nil
+}
+
+// Purge evicts name from the cache, forcing the next Lookup to re-probe
+// every host.
+func (r *Resolver) Purge(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.cache, name)
+}
+
+func (r *Resolver) cached(name string) (cacheEntry, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	entry, ok := r.cache[name]
+	if !ok {
+		return cacheEntry{}, false
+	}
+	if entry.expired(time.Now(), r.ExpireAfter, r.NegativeExpireAfter) {
+		delete(r.cache, name)
+		return cacheEntry{}, false
+	}
+	return entry, true
+}
+
+func (r *Resolver) store(name string, entry cacheEntry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cache[name] = entry
+}
+
+// probeHosts tries each configured host in order, returning the first one
+// that has the model. A transport-level error probing one host doesn't
+// stop the search; it's treated the same as a miss.
+func (r *Resolver) probeHosts(ctx context.Context, name string) (host string, found bool, err error) {
+	if len(r.Hosts) == 0 {
+		return "", false, fmt.Errorf("no hosts configured to resolve %q against", name)
+	}
+
+	for _, h := range r.Hosts {
+		ok, err := r.probeHost(ctx, h, name)
+		if err != nil {
+			continue
+		}
+		if ok {
+			return h, true, nil
+		}
+	}
+	return "", false, nil
+}
+
+func (r *Resolver) probeHost(ctx context.Context, host, name string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.UpstreamTimeout)
+	defer cancel()
+
+	scheme := ""
+	if r.SchemeFor != nil {
+		scheme = r.SchemeFor(host)
+	}
+	if scheme == "" {
+		scheme = "https"
+	}
+
+	url := fmt.Sprintf("%s://%s/v1/models/%s", scheme, host, name)
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
+	if err != nil {
+		return false, err
+	}
+
+	client := r.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	return resp.StatusCode == http.StatusOK, nil
+}
@@ -0,0 +1,138 @@
+package resolver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestServer(t *testing.T, status int, hits *int32) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if hits != nil {
+			atomic.AddInt32(hits, 1)
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func hostOf(t *testing.T, srv *httptest.Server) string {
+	t.Helper()
+	u, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatalf("failed to parse test server URL: %v", err)
+	}
+	return u.Host
+}
+
+// newTestResolver is New, but probing over plain HTTP so it can talk to
+// httptest.Server.
+func newTestResolver(hosts []string) *Resolver {
+	r := New(hosts)
+	r.SchemeFor = func(string) string { return "http" }
+	return r
+}
+
+func TestLookupReturnsFirstMatchingHost(t *testing.T) {
+	miss := newTestServer(t, http.StatusNotFound, nil)
+	hit := newTestServer(t, http.StatusOK, nil)
+
+	r := newTestResolver([]string{hostOf(t, miss), hostOf(t, hit)})
+	got, err := r.Lookup(context.Background(), "stable-diffusion")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != hostOf(t, hit) {
+		t.Fatalf("Lookup() = %q, want %q", got, hostOf(t, hit))
+	}
+}
+
+func TestLookupReturnsErrorWhenNoHostMatches(t *testing.T) {
+	miss := newTestServer(t, http.StatusNotFound, nil)
+
+	r := newTestResolver([]string{hostOf(t, miss)})
+	if _, err := r.Lookup(context.Background(), "stable-diffusion"); err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestLookupCachesPositiveResult(t *testing.T) {
+	var hits int32
+	hit := newTestServer(t, http.StatusOK, &hits)
+
+	r := newTestResolver([]string{hostOf(t, hit)})
+	for i := 0; i < 3; i++ {
+		if _, err := r.Lookup(context.Background(), "stable-diffusion"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if hits != 1 {
+		t.Fatalf("server was hit %d times, want 1", hits)
+	}
+}
+
+func TestLookupReprobesAfterNegativeTTLExpires(t *testing.T) {
+	var hits int32
+	miss := newTestServer(t, http.StatusNotFound, &hits)
+
+	r := newTestResolver([]string{hostOf(t, miss)})
+	r.NegativeExpireAfter = time.Millisecond
+
+	if _, err := r.Lookup(context.Background(), "stable-diffusion"); err == nil {
+		t.Fatal("expected an error on first lookup, got nil")
+	}
+	time.Sleep(5 * time.Millisecond)
+	if _, err := r.Lookup(context.Background(), "stable-diffusion"); err == nil {
+		t.Fatal("expected an error on second lookup, got nil")
+	}
+	if hits != 2 {
+		t.Fatalf("server was hit %d times, want 2", hits)
+	}
+}
+
+func TestPurgeForcesReResolution(t *testing.T) {
+	var hits int32
+	hit := newTestServer(t, http.StatusOK, &hits)
+
+	r := newTestResolver([]string{hostOf(t, hit)})
+	if _, err := r.Lookup(context.Background(), "stable-diffusion"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	r.Purge("stable-diffusion")
+	if _, err := r.Lookup(context.Background(), "stable-diffusion"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if hits != 2 {
+		t.Fatalf("server was hit %d times, want 2", hits)
+	}
+}
+
+func TestLookupCoalescesConcurrentMisses(t *testing.T) {
+	var hits int32
+	hit := newTestServer(t, http.StatusOK, &hits)
+
+	r := newTestResolver([]string{hostOf(t, hit)})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := r.Lookup(context.Background(), "stable-diffusion"); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if hits > 1 {
+		t.Fatalf("server was hit %d times, want at most 1", hits)
+	}
+}